@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// AzureManagedFleetMember references an AKS cluster, reconciled elsewhere in this repo, that should
+// be joined to the fleet.
+type AzureManagedFleetMember struct {
+	// ClusterResourceID is the Azure resource ID of the AKS cluster joining the fleet.
+	ClusterResourceID string `json:"clusterResourceID"`
+
+	// Group is the update group this member belongs to, used to stage rolling updates across members.
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
+// AzureManagedFleetHubProfile configures the fleet's hub cluster.
+type AzureManagedFleetHubProfile struct {
+	// DNSPrefix is the DNS prefix used to create the FQDN for the fleet hub.
+	DNSPrefix string `json:"dnsPrefix"`
+
+	// Version defines the desired Kubernetes version of the fleet hub.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// AzureManagedFleetSpec defines the desired state of AzureManagedFleet.
+type AzureManagedFleetSpec struct {
+	// ResourceGroup is the name of the Azure resource group for the fleet.
+	ResourceGroup string `json:"resourceGroup"`
+
+	// HubProfile configures the fleet's hub cluster.
+	HubProfile AzureManagedFleetHubProfile `json:"hubProfile"`
+
+	// Members lists the AKS clusters that should be joined to the fleet.
+	// +optional
+	Members []AzureManagedFleetMember `json:"members,omitempty"`
+}
+
+// AzureManagedFleetMemberStatus is the observed state of a single fleet member.
+type AzureManagedFleetMemberStatus struct {
+	// ClusterResourceID is the Azure resource ID of the AKS cluster this status applies to.
+	ClusterResourceID string `json:"clusterResourceID"`
+
+	// ProvisioningState is the provisioning state of this member's membership in the fleet.
+	// +optional
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
+
+// AzureManagedFleetStatus defines the observed state of AzureManagedFleet.
+type AzureManagedFleetStatus struct {
+	// Ready is true when the fleet and all of its members have reached the Succeeded provisioning state.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// ProvisioningState is the provisioning state of the fleet.
+	// +optional
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+
+	// MemberStatuses is the observed state of each member listed in spec.members. A member that
+	// Azure has not joined yet has no ProvisioningState.
+	// +optional
+	MemberStatuses []AzureManagedFleetMemberStatus `json:"memberStatuses,omitempty"`
+
+	// Conditions defines current service state of the AzureManagedFleet.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=azuremanagedfleets,scope=Namespaced,categories=cluster-api,shortName=amf
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Cluster infrastructure is ready for fleet member clusters"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.provisioningState",description="Azure fleet provisioning state"
+
+// AzureManagedFleet is the Schema for the azuremanagedfleets API.
+type AzureManagedFleet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureManagedFleetSpec   `json:"spec,omitempty"`
+	Status AzureManagedFleetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AzureManagedFleetList contains a list of AzureManagedFleet.
+type AzureManagedFleetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureManagedFleet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AzureManagedFleet{}, &AzureManagedFleetList{})
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// AzureManagedMachinePoolSpec defines the desired state of AzureManagedMachinePool.
+type AzureManagedMachinePoolSpec struct {
+	// SKU is the size of the VMs in the node pool.
+	SKU string `json:"sku"`
+
+	// OSType specifies the operating system for the node pool. Allowed values are 'Linux' and
+	// 'Windows'.
+	// +optional
+	OSType *string `json:"osType,omitempty"`
+
+	// WorkloadRuntime specifies the workload runtime for the node pool. Allowed values are
+	// 'OCIContainer', 'WasmWasi' and 'KataMshvVmIsolation'.
+	// +optional
+	WorkloadRuntime *string `json:"workloadRuntime,omitempty"`
+}
+
+// AzureManagedMachinePoolStatus defines the observed state of AzureManagedMachinePool.
+type AzureManagedMachinePoolStatus struct {
+	// Ready is true when the node pool has been created in Azure and is ready to run workloads.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// ProvisioningState is the provisioning state of the node pool.
+	// +optional
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+
+	// Conditions defines current service state of the AzureManagedMachinePool.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=azuremanagedmachinepools,scope=Namespaced,categories=cluster-api,shortName=ammp
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Node pool is ready"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.provisioningState",description="Azure agent pool provisioning state"
+
+// AzureManagedMachinePool is the Schema for the azuremanagedmachinepools API.
+type AzureManagedMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureManagedMachinePoolSpec   `json:"spec,omitempty"`
+	Status AzureManagedMachinePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AzureManagedMachinePoolList contains a list of AzureManagedMachinePool.
+type AzureManagedMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureManagedMachinePool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AzureManagedMachinePool{}, &AzureManagedMachinePoolList{})
+}
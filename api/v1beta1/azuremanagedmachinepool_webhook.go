@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"sigs.k8s.io/cluster-api-provider-azure/util/agentpools"
+)
+
+// SetupWebhookWithManager sets up and registers the webhook with the manager.
+func (amp *AzureManagedMachinePool) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(amp).
+		Complete()
+}
+
+var _ webhook.Validator = &AzureManagedMachinePool{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (amp *AzureManagedMachinePool) ValidateCreate() error {
+	return amp.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (amp *AzureManagedMachinePool) ValidateUpdate(_ runtime.Object) error {
+	return amp.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (amp *AzureManagedMachinePool) ValidateDelete() error {
+	return nil
+}
+
+// validate rejects AzureManagedMachinePool specs that AKS cannot satisfy.
+func (amp *AzureManagedMachinePool) validate() error {
+	var allErrs field.ErrorList
+
+	if fieldErr := agentpools.ValidateWorkloadRuntime(amp.Spec.WorkloadRuntime, amp.Spec.OSType, amp.Spec.SKU, field.NewPath("spec", "workloadRuntime")); fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: GroupVersion.Group, Kind: "AzureManagedMachinePool"},
+		amp.Name, allErrs)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// AzureManagedMachinePoolSnapshotSpec defines the desired state of AzureManagedMachinePoolSnapshot.
+type AzureManagedMachinePoolSnapshotSpec struct {
+	// ResourceGroup is the name of the Azure resource group for the snapshot.
+	ResourceGroup string `json:"resourceGroup"`
+
+	// SourceResourceID is the Azure resource ID of the agent pool this snapshot is taken from,
+	// pinning its image version, OS SKU and kubelet configuration.
+	SourceResourceID string `json:"sourceResourceID"`
+}
+
+// AzureManagedMachinePoolSnapshotStatus defines the observed state of AzureManagedMachinePoolSnapshot.
+type AzureManagedMachinePoolSnapshotStatus struct {
+	// Ready is true when the snapshot has been created in Azure and is available for use as an
+	// agent pool's CreationData.SourceResourceID.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// ProvisioningState is the provisioning state of the snapshot.
+	// +optional
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+
+	// Conditions defines current service state of the AzureManagedMachinePoolSnapshot.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=azuremanagedmachinepoolsnapshots,scope=Namespaced,categories=cluster-api,shortName=ammps
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Snapshot is ready to be used as an agent pool's creation source"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.provisioningState",description="Azure snapshot provisioning state"
+
+// AzureManagedMachinePoolSnapshot is the Schema for the azuremanagedmachinepoolsnapshots API.
+type AzureManagedMachinePoolSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureManagedMachinePoolSnapshotSpec   `json:"spec,omitempty"`
+	Status AzureManagedMachinePoolSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AzureManagedMachinePoolSnapshotList contains a list of AzureManagedMachinePoolSnapshot.
+type AzureManagedMachinePoolSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureManagedMachinePoolSnapshot `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AzureManagedMachinePoolSnapshot{}, &AzureManagedMachinePoolSnapshotList{})
+}
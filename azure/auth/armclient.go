@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+)
+
+// DefaultARMBaseURI is the Azure Resource Manager endpoint used when a client does not override it
+// (e.g. for a sovereign or air-gapped cloud).
+const DefaultARMBaseURI = "https://management.azure.com"
+
+// ARMClient issues authenticated requests directly against the Azure Resource Manager REST API
+// for resource types that do not yet have a generated SDK client vendored into this repo.
+type ARMClient struct {
+	// Cred is the credential, typically produced by NewCredential, used to obtain ARM access
+	// tokens.
+	Cred azidentity.TokenCredential
+
+	// BaseURI is the ARM endpoint to send requests to. Defaults to DefaultARMBaseURI when empty.
+	BaseURI string
+
+	// APIVersion is the api-version query parameter sent with every request.
+	APIVersion string
+}
+
+// PutResource creates or updates the ARM resource at resourceID with the given request body and
+// decodes the response into out, if out is non-nil.
+func (c *ARMClient) PutResource(ctx context.Context, resourceID string, body interface{}, out interface{}) error {
+	return c.do(ctx, http.MethodPut, resourceID, body, out)
+}
+
+// DeleteResource deletes the ARM resource at resourceID. A 404 response is treated as success.
+func (c *ARMClient) DeleteResource(ctx context.Context, resourceID string) error {
+	err := c.do(ctx, http.MethodDelete, resourceID, nil, nil)
+	if err != nil && IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// GetResource fetches the ARM resource at resourceID and decodes it into out.
+func (c *ARMClient) GetResource(ctx context.Context, resourceID string, out interface{}) error {
+	return c.do(ctx, http.MethodGet, resourceID, nil, out)
+}
+
+func (c *ARMClient) do(ctx context.Context, method, resourceID string, body interface{}, out interface{}) error {
+	baseURI := c.BaseURI
+	if baseURI == "" {
+		baseURI = DefaultARMBaseURI
+	}
+
+	token, err := c.Cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{baseURI + "/.default"}})
+	if err != nil {
+		return errors.Wrap(err, "failed to get ARM access token")
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode request body")
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	url := fmt.Sprintf("%s%s?api-version=%s", baseURI, resourceID, c.APIVersion)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return errors.Wrap(err, "failed to build ARM request")
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call %s %s", method, resourceID)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read ARM response body")
+	}
+
+	if resp.StatusCode >= 300 {
+		return &ResponseError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return errors.Wrap(err, "failed to decode ARM response body")
+		}
+	}
+
+	return nil
+}
+
+// ResponseError is returned when an ARM request completes with a non-2xx status code.
+type ResponseError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("ARM request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound returns true if err is a ResponseError with a 404 status code.
+func IsNotFound(err error) bool {
+	respErr, ok := err.(*ResponseError)
+	return ok && respErr.StatusCode == http.StatusNotFound
+}
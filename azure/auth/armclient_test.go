@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+type fakeCredential struct{}
+
+func (fakeCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "fake-token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestARMClientPutResource(t *testing.T) {
+	var gotMethod, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"name": "my-resource"})
+	}))
+	defer server.Close()
+
+	c := &ARMClient{Cred: fakeCredential{}, BaseURI: server.URL, APIVersion: "2023-01-01"}
+
+	var out map[string]string
+	if err := c.PutResource(context.Background(), "/subscriptions/x/resourceGroups/y/providers/Microsoft.Test/foos/my-resource", map[string]string{"hello": "world"}, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPut {
+		t.Errorf("expected PUT, got %s", gotMethod)
+	}
+	if gotAuth != "Bearer fake-token" {
+		t.Errorf("expected bearer token header, got %q", gotAuth)
+	}
+	if out["name"] != "my-resource" {
+		t.Errorf("expected decoded response body, got %#v", out)
+	}
+}
+
+func TestARMClientDeleteResourceTreatsNotFoundAsSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := &ARMClient{Cred: fakeCredential{}, BaseURI: server.URL, APIVersion: "2023-01-01"}
+	if err := c.DeleteResource(context.Background(), "/subscriptions/x/resourceGroups/y/providers/Microsoft.Test/foos/my-resource"); err != nil {
+		t.Fatalf("expected 404 to be treated as success, got error: %v", err)
+	}
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/jongio/azidext/go/azidext"
+)
+
+// NewAuthorizer adapts an azidentity.TokenCredential into the autorest.Authorizer expected by
+// this repo's generated (track 1) Azure SDK clients, so that resource service clients --
+// virtualmachines, networkinterfaces, and the rest -- can be migrated to azidentity-based credentials
+// one package at a time without waiting on a full track-2 SDK migration.
+func NewAuthorizer(cred azidentity.TokenCredential, scope string) autorest.Authorizer {
+	return azidext.NewTokenCredentialAdapter(cred, []string{scope})
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package auth constructs azidentity.TokenCredential chains for authenticating to Azure, so that
+// resource service clients no longer need to depend on autorest's service-principal authorizers.
+package auth
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+)
+
+// Settings configures how a credential chain is assembled. An empty Settings produces a chain
+// without workload identity: system-assigned managed identity, then environment, then the Azure CLI.
+type Settings struct {
+	// ClientID is the client ID of the workload identity or user-assigned managed identity to
+	// authenticate as. Left empty, the chain falls back to the system-assigned managed identity.
+	ClientID string
+
+	// TenantID is the Azure AD tenant to authenticate against.
+	TenantID string
+
+	// TokenFilePath is the path to the projected service account token used for workload
+	// identity federation. Left empty, workload identity is skipped.
+	TokenFilePath string
+}
+
+// NewCredential builds a ChainedTokenCredential that tries, in order: workload identity
+// federation (only when settings.TokenFilePath is set), managed identity, environment-variable
+// credentials, and the Azure CLI (each of the last two only if it can be constructed). This
+// replaces autorest service-principal authorizers so that the controller pod no longer needs a
+// long-lived client secret in a Kubernetes Secret.
+func NewCredential(settings Settings) (*azidentity.ChainedTokenCredential, error) {
+	var creds []azidentity.TokenCredential
+
+	if settings.TokenFilePath != "" {
+		workloadCred, err := azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+			ClientID:      settings.ClientID,
+			TenantID:      settings.TenantID,
+			TokenFilePath: settings.TokenFilePath,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create workload identity credential")
+		}
+		creds = append(creds, workloadCred)
+	}
+
+	managedIdentityOpts := &azidentity.ManagedIdentityCredentialOptions{}
+	if settings.ClientID != "" {
+		managedIdentityOpts.ID = azidentity.ClientID(settings.ClientID)
+	}
+	managedIdentityCred, err := azidentity.NewManagedIdentityCredential(managedIdentityOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create managed identity credential")
+	}
+	creds = append(creds, managedIdentityCred)
+
+	envCred, err := azidentity.NewEnvironmentCredential(nil)
+	if err == nil {
+		creds = append(creds, envCred)
+	}
+
+	cliCred, err := azidentity.NewAzureCLICredential(nil)
+	if err == nil {
+		creds = append(creds, cliCred)
+	}
+
+	chain, err := azidentity.NewChainedTokenCredential(creds, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create chained token credential")
+	}
+
+	return chain, nil
+}
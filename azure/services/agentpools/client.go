@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentpools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/auth"
+)
+
+// Service reconciles an AKS agent pool against Azure.
+type Service struct {
+	Client         *auth.ARMClient
+	SubscriptionID string
+}
+
+// NewService returns an agent pools Service that authenticates using cred.
+func NewService(cred azidentity.TokenCredential, subscriptionID string) *Service {
+	return &Service{
+		Client: &auth.ARMClient{
+			Cred:       cred,
+			APIVersion: agentPoolAPIVersion,
+		},
+		SubscriptionID: subscriptionID,
+	}
+}
+
+func (s *Service) resourceID(spec *AgentPoolSpec) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s/agentPools/%s",
+		s.SubscriptionID, spec.ResourceGroupName(), spec.OwnerResourceName(), spec.ResourceName())
+}
+
+// Get fetches the current state of the agent pool from Azure, returning a nil AgentPoolResource
+// if it does not exist yet.
+func (s *Service) Get(ctx context.Context, spec *AgentPoolSpec) (*AgentPoolResource, error) {
+	var existing *AgentPoolResource
+	if err := s.Client.GetResource(ctx, s.resourceID(spec), &existing); err != nil {
+		if auth.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get agent pool %s", spec.Name)
+	}
+	return existing, nil
+}
+
+// Reconcile creates or updates the agent pool.
+func (s *Service) Reconcile(ctx context.Context, spec *AgentPoolSpec) error {
+	existing, err := s.Get(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	var params interface{}
+	if existing == nil {
+		params, err = spec.Parameters(nil)
+	} else {
+		params, err = spec.Parameters(existing)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to build agent pool parameters")
+	}
+	if params == nil {
+		return nil
+	}
+
+	if err := s.Client.PutResource(ctx, s.resourceID(spec), params, nil); err != nil {
+		return errors.Wrapf(err, "failed to create or update agent pool %s", spec.Name)
+	}
+	return nil
+}
+
+// Delete deletes the agent pool.
+func (s *Service) Delete(ctx context.Context, spec *AgentPoolSpec) error {
+	if err := s.Client.DeleteResource(ctx, s.resourceID(spec)); err != nil {
+		return errors.Wrapf(err, "failed to delete agent pool %s", spec.Name)
+	}
+	return nil
+}
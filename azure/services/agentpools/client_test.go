@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentpools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/auth"
+)
+
+type fakeCredential struct{}
+
+func (fakeCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "fake-token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func newTestService(baseURI string) *Service {
+	return &Service{
+		Client: &auth.ARMClient{
+			Cred:       fakeCredential{},
+			BaseURI:    baseURI,
+			APIVersion: agentPoolAPIVersion,
+		},
+		SubscriptionID: "my-subscription",
+	}
+}
+
+func TestServiceReconcileCreatesAgentPoolWhenNotFound(t *testing.T) {
+	var puts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+		case http.MethodPut:
+			puts++
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(&AgentPoolResource{})
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	svc := newTestService(server.URL)
+	spec := &AgentPoolSpec{
+		Name:          "my-pool",
+		ResourceGroup: "my-rg",
+		Cluster:       "my-cluster",
+		SKU:           "Standard_D2s_v3",
+		Mode:          "User",
+	}
+
+	if err := svc.Reconcile(context.Background(), spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if puts != 1 {
+		t.Errorf("expected 1 PUT to create the agent pool, got %d", puts)
+	}
+}
+
+func TestServiceGetReturnsNilWhenNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	svc := newTestService(server.URL)
+	pool, err := svc.Get(context.Background(), &AgentPoolSpec{Name: "my-pool", ResourceGroup: "my-rg", Cluster: "my-cluster"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pool != nil {
+		t.Errorf("expected a nil agent pool when not found, got %#v", pool)
+	}
+}
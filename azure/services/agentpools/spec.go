@@ -20,15 +20,23 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-05-01/containerservice"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	agentpoolvalidation "sigs.k8s.io/cluster-api-provider-azure/util/agentpools"
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 )
 
+// agentPoolAPIVersion is the Microsoft.ContainerService/managedClusters/agentPools api-version
+// this package speaks. CreationData and WorkloadRuntime postdate the containerservice SDK
+// vendored into this repo (2021-05-01), so the agent pools client issues plain ARM REST calls
+// (see client.go) against a locally-defined wire representation rather than going through that
+// SDK.
+const agentPoolAPIVersion = "2023-02-01"
+
 // AgentPoolSpec contains agent pool specification details.
 type AgentPoolSpec struct {
 	// Name is the name of agent pool.
@@ -99,6 +107,60 @@ type AgentPoolSpec struct {
 
 	// ScaleSetPriority specifies the ScaleSetPriority for the node pool. Allowed values are 'Spot' and 'Regular'
 	ScaleSetPriority *string `json:"scaleSetPriority,omitempty"`
+
+	// CreationData specifies the source snapshot to provision this agent pool's nodes from.
+	CreationData *CreationData `json:"creationData,omitempty"`
+
+	// WorkloadRuntime specifies the workload runtime for the agent pool. Allowed values are
+	// 'OCIContainer', 'WasmWasi' and 'KataMshvVmIsolation'.
+	WorkloadRuntime *string `json:"workloadRuntime,omitempty"`
+
+	// PublicNetworkAccess controls whether nodes in the agent pool are reachable from the public
+	// internet. Allowed values are 'Enabled', 'Disabled' and 'SecuredByPerimeter'.
+	//
+	// NOTE: the AKS agent pool API does not yet expose this property; it is carried here so that
+	// it can be surfaced once the upstream API catches up with the managed cluster level setting.
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
+}
+
+// CreationData is the data source for a managed cluster agent pool.
+type CreationData struct {
+	// SourceResourceID is the resource ID of the source snapshot to provision this agent pool from.
+	SourceResourceID *string `json:"sourceResourceID,omitempty"`
+}
+
+// AgentPoolResource is the ARM request/response body for a
+// Microsoft.ContainerService/managedClusters/agentPools resource.
+type AgentPoolResource struct {
+	Name       string              `json:"name,omitempty"`
+	Properties AgentPoolProperties `json:"properties"`
+}
+
+// AgentPoolProperties is the properties payload of an AgentPoolResource.
+type AgentPoolProperties struct {
+	AvailabilityZones    *[]string          `json:"availabilityZones,omitempty"`
+	Count                *int32             `json:"count,omitempty"`
+	CreationData         *CreationData      `json:"creationData,omitempty"`
+	EnableAutoScaling    *bool              `json:"enableAutoScaling,omitempty"`
+	EnableUltraSSD       *bool              `json:"enableUltraSSD,omitempty"`
+	MaxCount             *int32             `json:"maxCount,omitempty"`
+	MaxPods              *int32             `json:"maxPods,omitempty"`
+	MinCount             *int32             `json:"minCount,omitempty"`
+	Mode                 string             `json:"mode,omitempty"`
+	NodeLabels           map[string]*string `json:"nodeLabels,omitempty"`
+	NodeTaints           *[]string          `json:"nodeTaints,omitempty"`
+	OrchestratorVersion  *string            `json:"orchestratorVersion,omitempty"`
+	OsDiskSizeGB         *int32             `json:"osDiskSizeGB,omitempty"`
+	OsDiskType           string             `json:"osDiskType,omitempty"`
+	OsType               string             `json:"osType,omitempty"`
+	ProvisioningState    string             `json:"provisioningState,omitempty"`
+	ScaleSetPriority     string             `json:"scaleSetPriority,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	VMSize               *string            `json:"vmSize,omitempty"`
+	VnetSubnetID         *string            `json:"vnetSubnetID,omitempty"`
+	EnableNodePublicIP   *bool              `json:"enableNodePublicIP,omitempty"`
+	NodePublicIPPrefixID *string            `json:"nodePublicIPPrefixID,omitempty"`
+	WorkloadRuntime      string             `json:"workloadRuntime,omitempty"`
 }
 
 // ResourceName returns the name of the agent pool.
@@ -123,45 +185,45 @@ func (s *AgentPoolSpec) CustomHeaders() map[string]string {
 
 // Parameters returns the parameters for the agent pool.
 func (s *AgentPoolSpec) Parameters(existing interface{}) (params interface{}, err error) {
+	if fieldErr := agentpoolvalidation.ValidateWorkloadRuntime(s.WorkloadRuntime, s.OSType, s.SKU, field.NewPath("spec", "workloadRuntime")); fieldErr != nil {
+		return nil, fieldErr
+	}
+
 	nodeLabels := s.NodeLabels
 	if existing != nil {
-		existingPool, ok := existing.(containerservice.AgentPool)
+		existingPool, ok := existing.(*AgentPoolResource)
 		if !ok {
-			return nil, errors.Errorf("%T is not a containerservice.AgentPool", existing)
+			return nil, errors.Errorf("%T is not a *agentpools.AgentPoolResource", existing)
 		}
 
 		// agent pool already exists
-		ps := *existingPool.ManagedClusterAgentPoolProfileProperties.ProvisioningState
+		ps := existingPool.Properties.ProvisioningState
 		if ps != string(infrav1.Canceled) && ps != string(infrav1.Failed) && ps != string(infrav1.Succeeded) {
 			msg := fmt.Sprintf("Unable to update existing agent pool in non terminal state. Agent pool must be in one of the following provisioning states: Canceled, Failed, or Succeeded. Actual state: %s", ps)
 			return nil, azure.WithTransientError(errors.New(msg), 20*time.Second)
 		}
 
 		// Normalize individual agent pools to diff in case we need to update
-		existingProfile := containerservice.AgentPool{
-			ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
-				Count:               existingPool.Count,
-				OrchestratorVersion: existingPool.OrchestratorVersion,
-				Mode:                existingPool.Mode,
-				EnableAutoScaling:   existingPool.EnableAutoScaling,
-				MinCount:            existingPool.MinCount,
-				MaxCount:            existingPool.MaxCount,
-				NodeLabels:          existingPool.NodeLabels,
-				NodeTaints:          existingPool.NodeTaints,
-			},
+		existingProfile := AgentPoolProperties{
+			Count:               existingPool.Properties.Count,
+			OrchestratorVersion: existingPool.Properties.OrchestratorVersion,
+			Mode:                existingPool.Properties.Mode,
+			EnableAutoScaling:   existingPool.Properties.EnableAutoScaling,
+			MinCount:            existingPool.Properties.MinCount,
+			MaxCount:            existingPool.Properties.MaxCount,
+			NodeLabels:          existingPool.Properties.NodeLabels,
+			NodeTaints:          existingPool.Properties.NodeTaints,
 		}
 
-		normalizedProfile := containerservice.AgentPool{
-			ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
-				Count:               &s.Replicas,
-				OrchestratorVersion: s.Version,
-				Mode:                containerservice.AgentPoolMode(s.Mode),
-				EnableAutoScaling:   s.EnableAutoScaling,
-				MinCount:            s.MinCount,
-				MaxCount:            s.MaxCount,
-				NodeLabels:          s.NodeLabels,
-				NodeTaints:          existingPool.NodeTaints,
-			},
+		normalizedProfile := AgentPoolProperties{
+			Count:               &s.Replicas,
+			OrchestratorVersion: s.Version,
+			Mode:                s.Mode,
+			EnableAutoScaling:   s.EnableAutoScaling,
+			MinCount:            s.MinCount,
+			MaxCount:            s.MaxCount,
+			NodeLabels:          s.NodeLabels,
+			NodeTaints:          existingPool.Properties.NodeTaints,
 		}
 
 		// When autoscaling is set, the count of the nodes differ based on the autoscaler and should not depend on the
@@ -181,7 +243,7 @@ func (s *AgentPoolSpec) Parameters(existing interface{}) (params interface{}, er
 		// So that we don't unintentionally delete them
 		// See https://github.com/Azure/AKS/issues/3152
 		if normalizedProfile.NodeLabels != nil {
-			nodeLabels = mergeSystemNodeLabels(normalizedProfile.NodeLabels, existingPool.NodeLabels)
+			nodeLabels = mergeSystemNodeLabels(normalizedProfile.NodeLabels, existingPool.Properties.NodeLabels)
 		}
 	}
 
@@ -206,28 +268,38 @@ func (s *AgentPoolSpec) Parameters(existing interface{}) (params interface{}, er
 		vnetSubnetID = &s.VnetSubnetID
 	}
 
-	return containerservice.AgentPool{
-		ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+	var creationData *CreationData
+	if s.CreationData != nil && s.CreationData.SourceResourceID != nil {
+		creationData = &CreationData{
+			SourceResourceID: s.CreationData.SourceResourceID,
+		}
+	}
+
+	return &AgentPoolResource{
+		Name: s.Name,
+		Properties: AgentPoolProperties{
 			AvailabilityZones:    availabilityZones,
 			Count:                replicas,
+			CreationData:         creationData,
 			EnableAutoScaling:    s.EnableAutoScaling,
 			EnableUltraSSD:       s.EnableUltraSSD,
 			MaxCount:             s.MaxCount,
 			MaxPods:              s.MaxPods,
 			MinCount:             s.MinCount,
-			Mode:                 containerservice.AgentPoolMode(s.Mode),
+			Mode:                 s.Mode,
 			NodeLabels:           nodeLabels,
 			NodeTaints:           nodeTaints,
 			OrchestratorVersion:  s.Version,
 			OsDiskSizeGB:         &s.OSDiskSizeGB,
-			OsDiskType:           containerservice.OSDiskType(to.String(s.OsDiskType)),
-			OsType:               containerservice.OSType(to.String(s.OSType)),
-			ScaleSetPriority:     containerservice.ScaleSetPriority(to.String(s.ScaleSetPriority)),
-			Type:                 containerservice.AgentPoolTypeVirtualMachineScaleSets,
+			OsDiskType:           to.String(s.OsDiskType),
+			OsType:               to.String(s.OSType),
+			ScaleSetPriority:     to.String(s.ScaleSetPriority),
+			Type:                 "VirtualMachineScaleSets",
 			VMSize:               sku,
 			VnetSubnetID:         vnetSubnetID,
 			EnableNodePublicIP:   s.EnableNodePublicIP,
 			NodePublicIPPrefixID: s.NodePublicIPPrefixID,
+			WorkloadRuntime:      to.String(s.WorkloadRuntime),
 		},
 	}, nil
 }
@@ -243,4 +315,4 @@ func mergeSystemNodeLabels(capz, aks map[string]*string) map[string]*string {
 		}
 	}
 	return ret
-}
\ No newline at end of file
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentpools
+
+import "testing"
+
+func TestAgentPoolSpecParametersRejectsInvalidWorkloadRuntime(t *testing.T) {
+	kata := "KataMshvVmIsolation"
+	windows := "Windows"
+
+	spec := &AgentPoolSpec{
+		Name:            "my-pool",
+		ResourceGroup:   "my-rg",
+		Cluster:         "my-cluster",
+		SKU:             "Standard_D2s_v3",
+		Mode:            "User",
+		OSType:          &windows,
+		WorkloadRuntime: &kata,
+	}
+
+	if _, err := spec.Parameters(nil); err == nil {
+		t.Error("expected an error for KataMshvVmIsolation on a Windows pool")
+	}
+}
+
+func TestAgentPoolSpecParametersThreadsCreationData(t *testing.T) {
+	sourceID := "/subscriptions/x/resourceGroups/y/providers/Microsoft.ContainerService/snapshots/my-snapshot"
+	spec := &AgentPoolSpec{
+		Name:          "my-pool",
+		ResourceGroup: "my-rg",
+		Cluster:       "my-cluster",
+		SKU:           "Standard_D2s_v3",
+		Mode:          "User",
+		CreationData:  &CreationData{SourceResourceID: &sourceID},
+	}
+
+	params, err := spec.Parameters(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pool, ok := params.(*AgentPoolResource)
+	if !ok {
+		t.Fatalf("expected *AgentPoolResource, got %T", params)
+	}
+	if pool.Properties.CreationData == nil || pool.Properties.CreationData.SourceResourceID == nil || *pool.Properties.CreationData.SourceResourceID != sourceID {
+		t.Errorf("expected CreationData.SourceResourceID %q, got %#v", sourceID, pool.Properties.CreationData)
+	}
+}
+
+func TestAgentPoolSpecParametersRejectsNonTerminalExisting(t *testing.T) {
+	spec := &AgentPoolSpec{
+		Name:          "my-pool",
+		ResourceGroup: "my-rg",
+		Cluster:       "my-cluster",
+		SKU:           "Standard_D2s_v3",
+		Mode:          "User",
+		Replicas:      3,
+	}
+	existing := &AgentPoolResource{
+		Properties: AgentPoolProperties{
+			ProvisioningState: "Updating",
+		},
+	}
+
+	if _, err := spec.Parameters(existing); err == nil {
+		t.Error("expected an error when the existing agent pool is in a non-terminal provisioning state")
+	}
+}
+
+func TestAgentPoolSpecParametersNoopWhenUpToDate(t *testing.T) {
+	spec := &AgentPoolSpec{
+		Name:          "my-pool",
+		ResourceGroup: "my-rg",
+		Cluster:       "my-cluster",
+		SKU:           "Standard_D2s_v3",
+		Mode:          "User",
+		Replicas:      3,
+	}
+	count := int32(3)
+	existing := &AgentPoolResource{
+		Properties: AgentPoolProperties{
+			ProvisioningState: "Succeeded",
+			Count:             &count,
+			Mode:              "User",
+		},
+	}
+
+	params, err := spec.Parameters(existing)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if params != nil {
+		t.Errorf("expected nil params when the agent pool is up to date, got %#v", params)
+	}
+}
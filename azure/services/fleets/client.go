@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/auth"
+)
+
+// Service reconciles an AKS Fleet Manager resource and its members against Azure.
+type Service struct {
+	Client         *auth.ARMClient
+	SubscriptionID string
+}
+
+// NewService returns a fleets Service that authenticates using cred.
+func NewService(cred azidentity.TokenCredential, subscriptionID string) *Service {
+	return &Service{
+		Client: &auth.ARMClient{
+			Cred:       cred,
+			APIVersion: fleetAPIVersion,
+		},
+		SubscriptionID: subscriptionID,
+	}
+}
+
+func (s *Service) fleetResourceID(spec *FleetSpec) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/fleets/%s",
+		s.SubscriptionID, spec.ResourceGroupName(), spec.ResourceName())
+}
+
+func (s *Service) memberResourceID(spec *FleetSpec, member FleetMemberSpec) string {
+	return fmt.Sprintf("%s/members/%s", s.fleetResourceID(spec), member.Name)
+}
+
+// Reconcile creates or updates the fleet, removes any joined member no longer present in
+// spec.Members, then creates or updates every member that is.
+func (s *Service) Reconcile(ctx context.Context, spec *FleetSpec) error {
+	var existing *FleetResource
+	getErr := s.Client.GetResource(ctx, s.fleetResourceID(spec), &existing)
+	if getErr != nil && !auth.IsNotFound(getErr) {
+		return errors.Wrapf(getErr, "failed to get fleet %s", spec.Name)
+	}
+
+	// getErr is non-nil (not found) the first time a fleet is reconciled: pass a literal nil
+	// rather than existing, which at this point is a non-nil interface wrapping a nil
+	// *FleetResource and would make Parameters take its "existing fleet" branch.
+	var params interface{}
+	var err error
+	if getErr == nil {
+		params, err = spec.Parameters(existing)
+	} else {
+		params, err = spec.Parameters(nil)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to build fleet parameters")
+	}
+	if params != nil {
+		if err := s.Client.PutResource(ctx, s.fleetResourceID(spec), params, nil); err != nil {
+			return errors.Wrapf(err, "failed to create or update fleet %s", spec.Name)
+		}
+	}
+
+	members, err := s.ListMembers(ctx, spec)
+	if err != nil {
+		return err
+	}
+	if err := s.RemoveMembers(ctx, spec, staleMembers(members, spec.Members)); err != nil {
+		return err
+	}
+
+	for _, member := range spec.Members {
+		if err := s.Client.PutResource(ctx, s.memberResourceID(spec, member), memberParameters(member), nil); err != nil {
+			return errors.Wrapf(err, "failed to join fleet member %s", member.Name)
+		}
+	}
+
+	return nil
+}
+
+// staleMembers returns the members of current that are no longer present in desired, by name.
+func staleMembers(current []FleetMemberResource, desired []FleetMemberSpec) []FleetMemberSpec {
+	want := make(map[string]struct{}, len(desired))
+	for _, m := range desired {
+		want[m.Name] = struct{}{}
+	}
+
+	var stale []FleetMemberSpec
+	for _, m := range current {
+		if _, ok := want[m.Name]; !ok {
+			stale = append(stale, FleetMemberSpec{Name: m.Name})
+		}
+	}
+	return stale
+}
+
+// Get fetches the current state of the fleet from Azure, returning a nil FleetResource if it
+// does not exist yet.
+func (s *Service) Get(ctx context.Context, spec *FleetSpec) (*FleetResource, error) {
+	var existing *FleetResource
+	if err := s.Client.GetResource(ctx, s.fleetResourceID(spec), &existing); err != nil {
+		if auth.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get fleet %s", spec.Name)
+	}
+	return existing, nil
+}
+
+// fleetMemberListResult is the ARM response envelope for a collection list request.
+type fleetMemberListResult struct {
+	Value []FleetMemberResource `json:"value"`
+}
+
+// ListMembers returns the members currently joined to the fleet in Azure, or an empty slice if
+// the fleet itself does not exist yet.
+func (s *Service) ListMembers(ctx context.Context, spec *FleetSpec) ([]FleetMemberResource, error) {
+	var result fleetMemberListResult
+	if err := s.Client.GetResource(ctx, s.fleetResourceID(spec)+"/members", &result); err != nil {
+		if auth.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to list members of fleet %s", spec.Name)
+	}
+	return result.Value, nil
+}
+
+// RemoveMembers leaves the fleet for every member in stale.
+func (s *Service) RemoveMembers(ctx context.Context, spec *FleetSpec, stale []FleetMemberSpec) error {
+	for _, member := range stale {
+		if err := s.Client.DeleteResource(ctx, s.memberResourceID(spec, member)); err != nil {
+			return errors.Wrapf(err, "failed to remove fleet member %s", member.Name)
+		}
+	}
+	return nil
+}
+
+// Delete removes every member listed in spec.Members, then deletes the fleet itself.
+func (s *Service) Delete(ctx context.Context, spec *FleetSpec) error {
+	if err := s.RemoveMembers(ctx, spec, spec.Members); err != nil {
+		return err
+	}
+	if err := s.Client.DeleteResource(ctx, s.fleetResourceID(spec)); err != nil {
+		return errors.Wrapf(err, "failed to delete fleet %s", spec.Name)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/auth"
+)
+
+type fakeCredential struct{}
+
+func (fakeCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "fake-token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func newTestService(baseURI string) *Service {
+	return &Service{
+		Client: &auth.ARMClient{
+			Cred:       fakeCredential{},
+			BaseURI:    baseURI,
+			APIVersion: fleetAPIVersion,
+		},
+		SubscriptionID: "my-subscription",
+	}
+}
+
+// TestServiceReconcileCreatesFleetWhenNotFound exercises the first-time-creation path, where
+// GetResource returns a 404. This is the path that used to panic on a nil *FleetResource
+// dereference before Parameters(nil) was called explicitly.
+func TestServiceReconcileCreatesFleetWhenNotFound(t *testing.T) {
+	var puts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+		case http.MethodPut:
+			puts++
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(&FleetResource{})
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	svc := newTestService(server.URL)
+	spec := &FleetSpec{
+		Name:          "my-fleet",
+		ResourceGroup: "my-rg",
+		HubProfile:    FleetHubProfile{DNSPrefix: "my-fleet-hub"},
+	}
+
+	if err := svc.Reconcile(context.Background(), spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if puts != 1 {
+		t.Errorf("expected 1 PUT to create the fleet, got %d", puts)
+	}
+}
+
+func TestServiceReconcileNoopWhenUpToDate(t *testing.T) {
+	existing := &FleetResource{
+		Properties: FleetProperties{
+			HubProfile: &FleetHubProfileResource{DNSPrefix: "my-fleet-hub"},
+		},
+	}
+	var puts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(existing)
+		case http.MethodPut:
+			puts++
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	svc := newTestService(server.URL)
+	spec := &FleetSpec{
+		Name:          "my-fleet",
+		ResourceGroup: "my-rg",
+		HubProfile:    FleetHubProfile{DNSPrefix: "my-fleet-hub"},
+	}
+
+	if err := svc.Reconcile(context.Background(), spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if puts != 0 {
+		t.Errorf("expected no PUT when the fleet is already up to date, got %d", puts)
+	}
+}
+
+// TestServiceReconcileRemovesStaleMembers exercises the path where Azure already has a member
+// joined that is no longer present in spec.Members: Reconcile must remove it rather than leaving
+// it joined forever.
+func TestServiceReconcileRemovesStaleMembers(t *testing.T) {
+	existingFleet := &FleetResource{
+		Properties: FleetProperties{
+			HubProfile: &FleetHubProfileResource{DNSPrefix: "my-fleet-hub"},
+		},
+	}
+	existingMembers := fleetMemberListResult{
+		Value: []FleetMemberResource{
+			{Name: "stays"},
+			{Name: "leaves"},
+		},
+	}
+	var deletes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/members"):
+			_ = json.NewEncoder(w).Encode(existingMembers)
+		case r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(existingFleet)
+		case r.Method == http.MethodDelete:
+			deletes = append(deletes, r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPut:
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	svc := newTestService(server.URL)
+	spec := &FleetSpec{
+		Name:          "my-fleet",
+		ResourceGroup: "my-rg",
+		HubProfile:    FleetHubProfile{DNSPrefix: "my-fleet-hub"},
+		Members:       []FleetMemberSpec{{Name: "stays"}},
+	}
+
+	if err := svc.Reconcile(context.Background(), spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deletes) != 1 || !strings.HasSuffix(deletes[0], "/members/leaves") {
+		t.Errorf("expected a single DELETE for the stale member, got %#v", deletes)
+	}
+}
+
+func TestServiceGetReturnsNilWhenNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	svc := newTestService(server.URL)
+	fleet, err := svc.Get(context.Background(), &FleetSpec{Name: "my-fleet", ResourceGroup: "my-rg"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fleet != nil {
+		t.Errorf("expected a nil fleet when not found, got %#v", fleet)
+	}
+}
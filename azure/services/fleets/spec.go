@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleets
+
+import (
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+// fleetAPIVersion is the Microsoft.ContainerService/fleets api-version this package speaks. The
+// fleets client issues plain ARM REST calls (see client.go) rather than going through a
+// generated SDK, because no vendored SDK in this repo exposes the fleets resource type yet.
+const fleetAPIVersion = "2023-03-15-preview"
+
+// FleetResource is the ARM request/response body for a Microsoft.ContainerService/fleets
+// resource.
+type FleetResource struct {
+	Name       string          `json:"name"`
+	Properties FleetProperties `json:"properties"`
+}
+
+// FleetProperties is the properties payload of a FleetResource.
+type FleetProperties struct {
+	HubProfile        *FleetHubProfileResource `json:"hubProfile,omitempty"`
+	ProvisioningState string                   `json:"provisioningState,omitempty"`
+}
+
+// FleetHubProfileResource is the wire representation of FleetHubProfile.
+type FleetHubProfileResource struct {
+	DNSPrefix string  `json:"dnsPrefix"`
+	Version   *string `json:"kubernetesVersion,omitempty"`
+}
+
+// FleetMemberResource is the ARM request/response body for a
+// Microsoft.ContainerService/fleets/members resource.
+type FleetMemberResource struct {
+	Name       string                `json:"name"`
+	Properties FleetMemberProperties `json:"properties"`
+}
+
+// FleetMemberProperties is the properties payload of a FleetMemberResource.
+type FleetMemberProperties struct {
+	ClusterResourceID string `json:"clusterResourceId"`
+	Group             string `json:"group,omitempty"`
+	ProvisioningState string `json:"provisioningState,omitempty"`
+}
+
+// FleetMemberSpec contains the specification of an AKS cluster that should be joined to a fleet.
+type FleetMemberSpec struct {
+	// Name is the name of the fleet member resource.
+	Name string
+
+	// ClusterResourceID is the Azure resource ID of the AKS cluster joining the fleet.
+	ClusterResourceID string
+
+	// Group is the update group this member belongs to, used to stage rolling updates across members.
+	Group string
+}
+
+// FleetHubProfile contains the configuration for the fleet's hub cluster.
+type FleetHubProfile struct {
+	// DNSPrefix is the DNS prefix used to create the FQDN for the fleet hub.
+	DNSPrefix string
+
+	// Version defines the desired Kubernetes version of the fleet hub.
+	Version *string
+}
+
+// FleetSpec contains the specification of an AKS Fleet Manager resource.
+type FleetSpec struct {
+	// Name is the name of the fleet.
+	Name string
+
+	// ResourceGroup is the name of the Azure resource group for the fleet.
+	ResourceGroup string
+
+	// HubProfile configures the fleet's hub cluster.
+	HubProfile FleetHubProfile
+
+	// Members lists the AKS clusters that should be joined to the fleet.
+	Members []FleetMemberSpec
+
+	// Headers is the list of headers to add to the HTTP requests to update this resource.
+	Headers map[string]string
+}
+
+// ResourceName returns the name of the fleet.
+func (s *FleetSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group.
+func (s *FleetSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for fleets.
+func (s *FleetSpec) OwnerResourceName() string {
+	return ""
+}
+
+// CustomHeaders returns custom headers to be added to the Azure API calls.
+func (s *FleetSpec) CustomHeaders() map[string]string {
+	return s.Headers
+}
+
+// Parameters returns the ARM request body for the fleet.
+func (s *FleetSpec) Parameters(existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		existingFleet, ok := existing.(*FleetResource)
+		if !ok {
+			return nil, errors.Errorf("%T is not a *fleets.FleetResource", existing)
+		}
+		if existingFleet.Properties.HubProfile != nil &&
+			existingFleet.Properties.HubProfile.DNSPrefix == s.HubProfile.DNSPrefix &&
+			versionEqual(existingFleet.Properties.HubProfile.Version, s.HubProfile.Version) {
+			// fleet is up to date, nothing to do
+			return nil, nil
+		}
+	}
+
+	return &FleetResource{
+		Name: s.Name,
+		Properties: FleetProperties{
+			HubProfile: &FleetHubProfileResource{
+				DNSPrefix: s.HubProfile.DNSPrefix,
+				Version:   s.HubProfile.Version,
+			},
+		},
+	}, nil
+}
+
+// versionEqual reports whether two optional Kubernetes version strings are equal, treating nil
+// and an empty string as unset.
+func versionEqual(a, b *string) bool {
+	var av, bv string
+	if a != nil {
+		av = *a
+	}
+	if b != nil {
+		bv = *b
+	}
+	return av == bv
+}
+
+// memberParameters returns the ARM request body for the given fleet member.
+func memberParameters(m FleetMemberSpec) *FleetMemberResource {
+	return &FleetMemberResource{
+		Name: m.Name,
+		Properties: FleetMemberProperties{
+			ClusterResourceID: m.ClusterResourceID,
+			Group:             m.Group,
+		},
+	}
+}
+
+// fleetProvisioningStates maps the Azure Kubernetes Fleet Manager provisioning states onto the
+// provisioning-state model shared across this codebase.
+var fleetProvisioningStates = map[string]infrav1.ProvisioningState{
+	"Creating":  infrav1.Creating,
+	"Updating":  infrav1.Updating,
+	"Succeeded": infrav1.Succeeded,
+	"Failed":    infrav1.Failed,
+	"Canceled":  infrav1.Canceled,
+	"Deleting":  infrav1.Deleting,
+}
+
+// fleetMemberProvisioningStates maps the Azure Kubernetes Fleet member provisioning states onto the
+// provisioning-state model shared across this codebase.
+var fleetMemberProvisioningStates = map[string]infrav1.ProvisioningState{
+	"Joining":   infrav1.Creating,
+	"Leaving":   infrav1.Deleting,
+	"Updating":  infrav1.Updating,
+	"Succeeded": infrav1.Succeeded,
+	"Failed":    infrav1.Failed,
+	"Canceled":  infrav1.Canceled,
+}
+
+// ProvisioningStateFromFleet converts an AKS Fleet Manager provisioning state into the equivalent
+// infrav1.ProvisioningState, returning infrav1.Failed if the state is not recognized.
+func ProvisioningStateFromFleet(state string) infrav1.ProvisioningState {
+	if ps, ok := fleetProvisioningStates[state]; ok {
+		return ps
+	}
+	return infrav1.Failed
+}
+
+// ProvisioningStateFromFleetMember converts an AKS Fleet member provisioning state into the
+// equivalent infrav1.ProvisioningState, returning infrav1.Failed if the state is not recognized.
+func ProvisioningStateFromFleetMember(state string) infrav1.ProvisioningState {
+	if ps, ok := fleetMemberProvisioningStates[state]; ok {
+		return ps
+	}
+	return infrav1.Failed
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleets
+
+import "testing"
+
+func TestFleetSpecParameters(t *testing.T) {
+	spec := &FleetSpec{
+		Name:          "my-fleet",
+		ResourceGroup: "my-rg",
+		HubProfile:    FleetHubProfile{DNSPrefix: "my-fleet-hub"},
+	}
+
+	params, err := spec.Parameters(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fleet, ok := params.(*FleetResource)
+	if !ok {
+		t.Fatalf("expected *FleetResource, got %T", params)
+	}
+	if fleet.Properties.HubProfile.DNSPrefix != "my-fleet-hub" {
+		t.Errorf("expected dns prefix %q, got %q", "my-fleet-hub", fleet.Properties.HubProfile.DNSPrefix)
+	}
+}
+
+func TestFleetSpecParametersIncludesVersion(t *testing.T) {
+	version := "1.28.3"
+	spec := &FleetSpec{
+		Name:          "my-fleet",
+		ResourceGroup: "my-rg",
+		HubProfile:    FleetHubProfile{DNSPrefix: "my-fleet-hub", Version: &version},
+	}
+	existing := &FleetResource{
+		Properties: FleetProperties{
+			HubProfile: &FleetHubProfileResource{DNSPrefix: "my-fleet-hub"},
+		},
+	}
+
+	params, err := spec.Parameters(existing)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fleet, ok := params.(*FleetResource)
+	if !ok {
+		t.Fatalf("expected *FleetResource, got %T", params)
+	}
+	if fleet.Properties.HubProfile.Version == nil || *fleet.Properties.HubProfile.Version != version {
+		t.Errorf("expected version %q to be carried into the ARM body, got %v", version, fleet.Properties.HubProfile.Version)
+	}
+}
+
+func TestFleetSpecParametersNoopWhenUpToDate(t *testing.T) {
+	spec := &FleetSpec{
+		Name:          "my-fleet",
+		ResourceGroup: "my-rg",
+		HubProfile:    FleetHubProfile{DNSPrefix: "my-fleet-hub"},
+	}
+	existing := &FleetResource{
+		Properties: FleetProperties{
+			HubProfile: &FleetHubProfileResource{DNSPrefix: "my-fleet-hub"},
+		},
+	}
+
+	params, err := spec.Parameters(existing)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if params != nil {
+		t.Errorf("expected nil params when fleet is up to date, got %#v", params)
+	}
+}
+
+func TestFleetSpecParametersWrongExistingType(t *testing.T) {
+	spec := &FleetSpec{Name: "my-fleet"}
+	if _, err := spec.Parameters("not-a-fleet"); err == nil {
+		t.Error("expected an error for an unexpected existing type")
+	}
+}
+
+func TestProvisioningStateFromFleet(t *testing.T) {
+	cases := map[string]string{
+		"Creating":  "Creating",
+		"Succeeded": "Succeeded",
+		"bogus":     "Failed",
+	}
+	for state, want := range cases {
+		if got := string(ProvisioningStateFromFleet(state)); got != want {
+			t.Errorf("ProvisioningStateFromFleet(%q) = %q, want %q", state, got, want)
+		}
+	}
+}
+
+func TestStaleMembers(t *testing.T) {
+	current := []FleetMemberResource{
+		{Name: "keep-me"},
+		{Name: "remove-me"},
+	}
+	desired := []FleetMemberSpec{
+		{Name: "keep-me"},
+	}
+
+	stale := staleMembers(current, desired)
+	if len(stale) != 1 || stale[0].Name != "remove-me" {
+		t.Errorf("expected only %q to be stale, got %#v", "remove-me", stale)
+	}
+}
+
+func TestProvisioningStateFromFleetMember(t *testing.T) {
+	cases := map[string]string{
+		"Joining": "Creating",
+		"Leaving": "Deleting",
+		"bogus":   "Failed",
+	}
+	for state, want := range cases {
+		if got := string(ProvisioningStateFromFleetMember(state)); got != want {
+			t.Errorf("ProvisioningStateFromFleetMember(%q) = %q, want %q", state, got, want)
+		}
+	}
+}
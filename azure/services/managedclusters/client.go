@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedclusters
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/auth"
+)
+
+// Service reconciles the parts of an AKS managed cluster's network posture that this package
+// owns, such as its Network Security Perimeter association. The managed cluster resource itself
+// is created and updated elsewhere in this codebase; this Service only issues the follow-on calls
+// that the containerservice SDK does not yet cover.
+type Service struct {
+	Client *auth.ARMClient
+}
+
+// NewService returns a managedclusters Service that authenticates using cred.
+func NewService(cred azidentity.TokenCredential) *Service {
+	return &Service{
+		Client: &auth.ARMClient{
+			Cred: cred,
+		},
+	}
+}
+
+// ReconcileNSPAssociation creates or updates the Network Security Perimeter association described
+// by spec.NSPAssociation, linking it to clusterResourceID. It is a no-op if spec.NSPAssociation is
+// unset; spec.PublicNetworkAccess is not checked here.
+func (s *Service) ReconcileNSPAssociation(ctx context.Context, spec *ManagedClusterSpec, clusterResourceID string) error {
+	if spec.NSPAssociation == nil {
+		return nil
+	}
+
+	if err := ReconcileNSPAssociation(ctx, s.Client, *spec.NSPAssociation, clusterResourceID); err != nil {
+		return errors.Wrapf(err, "failed to reconcile NSP association %s for cluster %s", spec.NSPAssociation.Name, spec.Name)
+	}
+
+	return nil
+}
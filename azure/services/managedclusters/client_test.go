@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedclusters
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"sigs.k8s.io/cluster-api-provider-azure/azure/auth"
+)
+
+func TestServiceReconcileNSPAssociationNoopWithoutAssociation(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	svc := &Service{Client: &auth.ARMClient{Cred: fakeCredential{}, BaseURI: server.URL}}
+	spec := &ManagedClusterSpec{Name: "my-cluster", ResourceGroup: "my-rg"}
+
+	if err := svc.ReconcileNSPAssociation(context.Background(), spec, "cluster-id"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if called {
+		t.Error("expected no ARM call when NSPAssociation is unset")
+	}
+}
+
+func TestServiceReconcileNSPAssociationIssuesPut(t *testing.T) {
+	var gotMethod string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	svc := &Service{Client: &auth.ARMClient{Cred: fakeCredential{}, BaseURI: server.URL}}
+	spec := &ManagedClusterSpec{
+		Name:                "my-cluster",
+		ResourceGroup:       "my-rg",
+		PublicNetworkAccess: PublicNetworkAccessSecuredByPerimeter,
+		NSPAssociation: &NSPAssociationSpec{
+			Name:                "my-association",
+			PerimeterResourceID: "/subscriptions/x/resourceGroups/y/providers/Microsoft.Network/networkSecurityPerimeters/my-nsp",
+			ProfileName:         "default",
+			AccessMode:          "Enforced",
+		},
+	}
+
+	if err := svc.ReconcileNSPAssociation(context.Background(), spec, "cluster-id"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPut {
+		t.Errorf("expected PUT, got %s", gotMethod)
+	}
+}
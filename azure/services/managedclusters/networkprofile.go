@@ -0,0 +1,46 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package managedclusters builds the AKS managed cluster fields it owns (network profile and
+// public network access) and reconciles the cluster's Network Security Perimeter association, as
+// opposed to its node pools (see the sibling agentpools package). The managed cluster resource
+// itself is created and updated elsewhere in this codebase.
+package managedclusters
+
+// NetworkProfile is the wire representation of the network profile fields this package owns.
+type NetworkProfile struct {
+	EbpfDataplane string `json:"ebpfDataplane,omitempty"`
+}
+
+// NetworkProfileSpec contains the network profile fields of a managed cluster that this package
+// threads through to the Azure API.
+type NetworkProfileSpec struct {
+	// EbpfDataplane selects the eBPF dataplane for the cluster's network profile. The only
+	// supported value today is 'cilium'.
+	EbpfDataplane *string
+}
+
+// setNetworkProfile applies the NetworkProfileSpec onto the given NetworkProfile, leaving any
+// fields set by other parts of the cluster spec untouched.
+func setNetworkProfile(spec NetworkProfileSpec, networkProfile *NetworkProfile) {
+	if networkProfile == nil {
+		return
+	}
+
+	if spec.EbpfDataplane != nil {
+		networkProfile.EbpfDataplane = *spec.EbpfDataplane
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedclusters
+
+import (
+	"context"
+
+	"sigs.k8s.io/cluster-api-provider-azure/azure/auth"
+)
+
+// NSPAssociationSpec describes a Microsoft.Network/networkSecurityPerimeters/resourceAssociations
+// child resource linking the managed cluster's control plane to a customer-owned Network Security
+// Perimeter.
+type NSPAssociationSpec struct {
+	// Name is the name of the resource association.
+	Name string
+
+	// PerimeterResourceID is the Azure resource ID of the Network Security Perimeter.
+	PerimeterResourceID string
+
+	// ProfileName is the name of the NSP access profile to associate the cluster with.
+	ProfileName string
+
+	// AccessMode controls whether traffic that matches the association is learned, audited, or
+	// enforced. Allowed values are 'Learning', 'Enforced' and 'Audit'.
+	AccessMode string
+}
+
+// PublicNetworkAccess controls whether the managed cluster's control plane is reachable from the
+// public internet. Allowed values are 'Enabled', 'Disabled' and 'SecuredByPerimeter'. Choosing
+// 'SecuredByPerimeter' requires an NSPAssociationSpec so that the control plane can be linked to
+// a Network Security Perimeter.
+type PublicNetworkAccess string
+
+const (
+	// PublicNetworkAccessEnabled allows unrestricted public access to the control plane.
+	PublicNetworkAccessEnabled PublicNetworkAccess = "Enabled"
+	// PublicNetworkAccessDisabled disables public access to the control plane entirely.
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	// PublicNetworkAccessSecuredByPerimeter restricts public access to what is explicitly allowed
+	// by an associated Network Security Perimeter.
+	PublicNetworkAccessSecuredByPerimeter PublicNetworkAccess = "SecuredByPerimeter"
+)
+
+// setPublicNetworkAccess applies the PublicNetworkAccess setting onto a managed cluster's
+// properties.
+func setPublicNetworkAccess(access PublicNetworkAccess, properties *ManagedClusterProperties) {
+	if access == "" || properties == nil {
+		return
+	}
+	properties.PublicNetworkAccess = string(access)
+}
+
+// nspResourceAssociationAPIVersion is the Microsoft.Network api-version this package speaks when
+// creating a resourceAssociations child resource under a Network Security Perimeter.
+const nspResourceAssociationAPIVersion = "2021-02-01-preview"
+
+// NSPResourceAssociation is the ARM request body for a
+// Microsoft.Network/networkSecurityPerimeters/resourceAssociations resource.
+type NSPResourceAssociation struct {
+	Name       string                           `json:"name"`
+	Properties NSPResourceAssociationProperties `json:"properties"`
+}
+
+// NSPResourceAssociationProperties is the properties payload of an NSPResourceAssociation.
+type NSPResourceAssociationProperties struct {
+	PrivateLinkResource NSPResourceReference `json:"privateLinkResource"`
+	Profile             NSPResourceReference `json:"profile"`
+	AccessMode          string               `json:"accessMode"`
+}
+
+// NSPResourceReference is an ARM resource reference by ID.
+type NSPResourceReference struct {
+	ID string `json:"id"`
+}
+
+// nspResourceAssociationParameters builds the parameters for the
+// Microsoft.Network/networkSecurityPerimeters/resourceAssociations child resource that links the
+// managed cluster to the given Network Security Perimeter.
+func nspResourceAssociationParameters(spec NSPAssociationSpec, clusterResourceID string) *NSPResourceAssociation {
+	return &NSPResourceAssociation{
+		Name: spec.Name,
+		Properties: NSPResourceAssociationProperties{
+			PrivateLinkResource: NSPResourceReference{ID: clusterResourceID},
+			Profile:             NSPResourceReference{ID: spec.PerimeterResourceID + "/profiles/" + spec.ProfileName},
+			AccessMode:          spec.AccessMode,
+		},
+	}
+}
+
+// nspResourceAssociationResourceID returns the Azure resource ID of the resourceAssociations
+// child resource described by spec.
+func nspResourceAssociationResourceID(spec NSPAssociationSpec) string {
+	return spec.PerimeterResourceID + "/resourceAssociations/" + spec.Name
+}
+
+// ReconcileNSPAssociation creates or updates the Network Security Perimeter association that
+// links clusterResourceID to spec's perimeter, using client to issue the ARM request.
+func ReconcileNSPAssociation(ctx context.Context, client *auth.ARMClient, spec NSPAssociationSpec, clusterResourceID string) error {
+	nspClient := &auth.ARMClient{Cred: client.Cred, BaseURI: client.BaseURI, APIVersion: nspResourceAssociationAPIVersion}
+	params := nspResourceAssociationParameters(spec, clusterResourceID)
+	return nspClient.PutResource(ctx, nspResourceAssociationResourceID(spec), params, nil)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedclusters
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/auth"
+)
+
+type fakeCredential struct{}
+
+func (fakeCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "fake-token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestReconcileNSPAssociation(t *testing.T) {
+	var gotMethod, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &auth.ARMClient{Cred: fakeCredential{}, BaseURI: server.URL}
+	spec := NSPAssociationSpec{
+		Name:                "my-association",
+		PerimeterResourceID: "/subscriptions/x/resourceGroups/y/providers/Microsoft.Network/networkSecurityPerimeters/my-nsp",
+		ProfileName:         "default",
+		AccessMode:          "Enforced",
+	}
+
+	if err := ReconcileNSPAssociation(context.Background(), client, spec, "/subscriptions/x/resourceGroups/y/providers/Microsoft.ContainerService/managedClusters/my-cluster"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPut {
+		t.Errorf("expected PUT, got %s", gotMethod)
+	}
+	wantPath := "/subscriptions/x/resourceGroups/y/providers/Microsoft.Network/networkSecurityPerimeters/my-nsp/resourceAssociations/my-association"
+	if gotPath != wantPath {
+		t.Errorf("expected path %q, got %q", wantPath, gotPath)
+	}
+}
+
+func TestNspResourceAssociationParametersBuildsFullProfileResourceID(t *testing.T) {
+	spec := NSPAssociationSpec{
+		Name:                "my-association",
+		PerimeterResourceID: "/subscriptions/x/resourceGroups/y/providers/Microsoft.Network/networkSecurityPerimeters/my-nsp",
+		ProfileName:         "default",
+		AccessMode:          "Enforced",
+	}
+
+	params := nspResourceAssociationParameters(spec, "cluster-id")
+	wantProfileID := spec.PerimeterResourceID + "/profiles/default"
+	if params.Properties.Profile.ID != wantProfileID {
+		t.Errorf("expected profile ID %q, got %q", wantProfileID, params.Properties.Profile.ID)
+	}
+}
+
+func TestManagedClusterSpecParametersRequiresNSPAssociationWhenSecured(t *testing.T) {
+	spec := &ManagedClusterSpec{
+		Name:                "my-cluster",
+		ResourceGroup:       "my-rg",
+		PublicNetworkAccess: PublicNetworkAccessSecuredByPerimeter,
+	}
+
+	if _, err := spec.Parameters(nil); err == nil {
+		t.Error("expected an error when PublicNetworkAccess is SecuredByPerimeter without an NSPAssociation")
+	}
+}
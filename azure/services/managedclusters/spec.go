@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedclusters
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ManagedClusterResource is the ARM request/response body for the fields of a
+// Microsoft.ContainerService/managedClusters resource that this package owns. Callers merge this
+// into the broader request body that the rest of the codebase assembles for the remaining fields.
+type ManagedClusterResource struct {
+	Properties ManagedClusterProperties `json:"properties"`
+}
+
+// ManagedClusterProperties is the subset of a ManagedClusterResource's properties that this
+// package owns. EbpfDataplane and PublicNetworkAccess postdate the containerservice SDK vendored
+// into this repo (2021-05-01), so this package builds its own wire representation of the fields it
+// owns rather than going through that SDK.
+type ManagedClusterProperties struct {
+	NetworkProfile      *NetworkProfile `json:"networkProfile,omitempty"`
+	PublicNetworkAccess string          `json:"publicNetworkAccess,omitempty"`
+}
+
+// ManagedClusterSpec contains the specification fields of an AKS managed cluster that this
+// package threads through to the Azure API. It only carries the fields this package currently
+// reconciles; the bulk of the managed cluster spec is owned by other parts of this codebase.
+type ManagedClusterSpec struct {
+	// Name is the name of the managed cluster.
+	Name string
+
+	// ResourceGroup is the name of the Azure resource group for the managed cluster.
+	ResourceGroup string
+
+	// NetworkProfile carries the network profile fields this package owns, such as the eBPF
+	// dataplane selection.
+	NetworkProfile NetworkProfileSpec
+
+	// PublicNetworkAccess controls whether the control plane is reachable from the public
+	// internet. See the PublicNetworkAccess type for allowed values.
+	PublicNetworkAccess PublicNetworkAccess
+
+	// NSPAssociation associates the control plane with a customer-owned Network Security
+	// Perimeter. It is required when PublicNetworkAccess is PublicNetworkAccessSecuredByPerimeter.
+	NSPAssociation *NSPAssociationSpec
+}
+
+// ResourceName returns the name of the managed cluster.
+func (s *ManagedClusterSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group.
+func (s *ManagedClusterSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for managed clusters.
+func (s *ManagedClusterSpec) OwnerResourceName() string {
+	return ""
+}
+
+// CustomHeaders returns custom headers to be added to the Azure API calls.
+func (s *ManagedClusterSpec) CustomHeaders() map[string]string {
+	return nil
+}
+
+// Parameters returns the parameters for the managed cluster's network profile and public network
+// access setting. Call sites merge
+// this into the broader ManagedCluster payload that the rest of the codebase assembles.
+func (s *ManagedClusterSpec) Parameters(existing interface{}) (params interface{}, err error) {
+	if s.PublicNetworkAccess == PublicNetworkAccessSecuredByPerimeter && s.NSPAssociation == nil {
+		return nil, errors.New("NSPAssociation is required when PublicNetworkAccess is SecuredByPerimeter")
+	}
+
+	networkProfile := &NetworkProfile{}
+
+	if existing != nil {
+		existingCluster, ok := existing.(*ManagedClusterResource)
+		if !ok {
+			return nil, errors.Errorf("%T is not a *managedclusters.ManagedClusterResource", existing)
+		}
+		if existingCluster.Properties.NetworkProfile != nil {
+			networkProfile = existingCluster.Properties.NetworkProfile
+		}
+	}
+
+	setNetworkProfile(s.NetworkProfile, networkProfile)
+
+	properties := ManagedClusterProperties{
+		NetworkProfile: networkProfile,
+	}
+	setPublicNetworkAccess(s.PublicNetworkAccess, &properties)
+
+	return &ManagedClusterResource{
+		Properties: properties,
+	}, nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedclusters
+
+import "testing"
+
+func TestManagedClusterSpecParametersSetsEbpfDataplane(t *testing.T) {
+	cilium := "cilium"
+	spec := &ManagedClusterSpec{
+		Name:           "my-cluster",
+		ResourceGroup:  "my-rg",
+		NetworkProfile: NetworkProfileSpec{EbpfDataplane: &cilium},
+	}
+
+	params, err := spec.Parameters(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cluster, ok := params.(*ManagedClusterResource)
+	if !ok {
+		t.Fatalf("expected *ManagedClusterResource, got %T", params)
+	}
+	if cluster.Properties.NetworkProfile.EbpfDataplane != cilium {
+		t.Errorf("expected EbpfDataplane %q, got %q", cilium, cluster.Properties.NetworkProfile.EbpfDataplane)
+	}
+}
+
+func TestManagedClusterSpecParametersPreservesExistingNetworkProfile(t *testing.T) {
+	spec := &ManagedClusterSpec{Name: "my-cluster", ResourceGroup: "my-rg"}
+	existing := &ManagedClusterResource{
+		Properties: ManagedClusterProperties{
+			NetworkProfile: &NetworkProfile{
+				EbpfDataplane: "cilium",
+			},
+		},
+	}
+
+	params, err := spec.Parameters(existing)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cluster := params.(*ManagedClusterResource)
+	if cluster.Properties.NetworkProfile.EbpfDataplane != "cilium" {
+		t.Errorf("expected existing EbpfDataplane to be preserved, got %q", cluster.Properties.NetworkProfile.EbpfDataplane)
+	}
+}
+
+func TestManagedClusterSpecParametersWrongExistingType(t *testing.T) {
+	spec := &ManagedClusterSpec{Name: "my-cluster"}
+	if _, err := spec.Parameters("not-a-cluster"); err == nil {
+		t.Error("expected an error for an unexpected existing type")
+	}
+}
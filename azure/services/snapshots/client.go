@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshots
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/auth"
+)
+
+// Service reconciles an AKS node pool snapshot against Azure.
+type Service struct {
+	Client         *auth.ARMClient
+	SubscriptionID string
+}
+
+// NewService returns a snapshots Service that authenticates using cred.
+func NewService(cred azidentity.TokenCredential, subscriptionID string) *Service {
+	return &Service{
+		Client: &auth.ARMClient{
+			Cred:       cred,
+			APIVersion: snapshotAPIVersion,
+		},
+		SubscriptionID: subscriptionID,
+	}
+}
+
+func (s *Service) resourceID(spec *SnapshotSpec) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/snapshots/%s",
+		s.SubscriptionID, spec.ResourceGroupName(), spec.ResourceName())
+}
+
+// Reconcile creates the snapshot if it does not already exist. Snapshots are immutable, so an
+// existing snapshot is left untouched.
+func (s *Service) Reconcile(ctx context.Context, spec *SnapshotSpec) error {
+	var existing *SnapshotResource
+	getErr := s.Client.GetResource(ctx, s.resourceID(spec), &existing)
+	if getErr != nil && !auth.IsNotFound(getErr) {
+		return errors.Wrapf(getErr, "failed to get snapshot %s", spec.Name)
+	}
+	if getErr == nil {
+		// snapshot already exists, and snapshots are immutable: nothing to do.
+		return nil
+	}
+
+	params, err := spec.Parameters(nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to build snapshot parameters")
+	}
+
+	if err := s.Client.PutResource(ctx, s.resourceID(spec), params, nil); err != nil {
+		return errors.Wrapf(err, "failed to create snapshot %s", spec.Name)
+	}
+
+	return nil
+}
+
+// Get fetches the current state of the snapshot from Azure, returning a nil SnapshotResource if
+// it does not exist yet.
+func (s *Service) Get(ctx context.Context, spec *SnapshotSpec) (*SnapshotResource, error) {
+	var existing *SnapshotResource
+	if err := s.Client.GetResource(ctx, s.resourceID(spec), &existing); err != nil {
+		if auth.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get snapshot %s", spec.Name)
+	}
+	return existing, nil
+}
+
+// Delete deletes the snapshot.
+func (s *Service) Delete(ctx context.Context, spec *SnapshotSpec) error {
+	if err := s.Client.DeleteResource(ctx, s.resourceID(spec)); err != nil {
+		return errors.Wrapf(err, "failed to delete snapshot %s", spec.Name)
+	}
+	return nil
+}
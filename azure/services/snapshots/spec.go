@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package snapshots reconciles Microsoft.ContainerService/snapshots resources, which capture the
+// image version, OS SKU and kubelet configuration of a source agent pool so that it can be
+// reproduced across node pools and clusters.
+package snapshots
+
+import (
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+// snapshotAPIVersion is the Microsoft.ContainerService/snapshots api-version this package speaks.
+// That resource type postdates the containerservice SDK that was vendored into this repo
+// (2021-05-01), so the snapshots client issues plain ARM REST calls (see client.go) instead of
+// depending on a generated SDK client for it.
+const snapshotAPIVersion = "2022-07-01"
+
+// SnapshotResource is the ARM request/response body for a Microsoft.ContainerService/snapshots
+// resource.
+type SnapshotResource struct {
+	Name       string             `json:"name"`
+	Properties SnapshotProperties `json:"properties"`
+}
+
+// SnapshotProperties is the properties payload of a SnapshotResource.
+type SnapshotProperties struct {
+	CreationData      CreationDataResource `json:"creationData"`
+	ProvisioningState string               `json:"provisioningState,omitempty"`
+}
+
+// CreationDataResource is the wire representation of a snapshot's source.
+type CreationDataResource struct {
+	SourceResourceID string `json:"sourceResourceId"`
+}
+
+// SnapshotSpec contains the specification for an AKS node pool snapshot.
+type SnapshotSpec struct {
+	// Name is the name of the snapshot.
+	Name string
+
+	// ResourceGroup is the name of the Azure resource group for the snapshot.
+	ResourceGroup string
+
+	// SourceResourceID is the Azure resource ID of the agent pool this snapshot is taken from.
+	SourceResourceID string
+}
+
+// ResourceName returns the name of the snapshot.
+func (s *SnapshotSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group.
+func (s *SnapshotSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for snapshots.
+func (s *SnapshotSpec) OwnerResourceName() string {
+	return ""
+}
+
+// CustomHeaders returns custom headers to be added to the Azure API calls.
+func (s *SnapshotSpec) CustomHeaders() map[string]string {
+	return nil
+}
+
+// Parameters returns the ARM request body for the snapshot.
+func (s *SnapshotSpec) Parameters(existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		if _, ok := existing.(*SnapshotResource); !ok {
+			return nil, errors.Errorf("%T is not a *snapshots.SnapshotResource", existing)
+		}
+		// Snapshots are immutable once created, so there is never anything to update.
+		return nil, nil
+	}
+
+	return &SnapshotResource{
+		Name: s.Name,
+		Properties: SnapshotProperties{
+			CreationData: CreationDataResource{
+				SourceResourceID: s.SourceResourceID,
+			},
+		},
+	}, nil
+}
+
+// snapshotProvisioningStates maps the Microsoft.ContainerService/snapshots provisioning states
+// onto the provisioning-state model shared across this codebase.
+var snapshotProvisioningStates = map[string]infrav1.ProvisioningState{
+	"Creating":  infrav1.Creating,
+	"Succeeded": infrav1.Succeeded,
+	"Failed":    infrav1.Failed,
+	"Canceled":  infrav1.Canceled,
+}
+
+// ProvisioningStateFromSnapshot converts a Microsoft.ContainerService/snapshots provisioning
+// state into the equivalent infrav1.ProvisioningState, returning infrav1.Failed if the state is
+// not recognized.
+func ProvisioningStateFromSnapshot(state string) infrav1.ProvisioningState {
+	if ps, ok := snapshotProvisioningStates[state]; ok {
+		return ps
+	}
+	return infrav1.Failed
+}
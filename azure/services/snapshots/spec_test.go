@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshots
+
+import "testing"
+
+func TestSnapshotSpecParameters(t *testing.T) {
+	spec := &SnapshotSpec{
+		Name:             "my-snapshot",
+		ResourceGroup:    "my-rg",
+		SourceResourceID: "/subscriptions/x/resourceGroups/y/providers/Microsoft.ContainerService/managedClusters/z/agentPools/w",
+	}
+
+	params, err := spec.Parameters(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	snapshot, ok := params.(*SnapshotResource)
+	if !ok {
+		t.Fatalf("expected *SnapshotResource, got %T", params)
+	}
+	if snapshot.Properties.CreationData.SourceResourceID != spec.SourceResourceID {
+		t.Errorf("expected source resource ID %q, got %q", spec.SourceResourceID, snapshot.Properties.CreationData.SourceResourceID)
+	}
+}
+
+func TestSnapshotSpecParametersExistingIsNoop(t *testing.T) {
+	spec := &SnapshotSpec{Name: "my-snapshot"}
+	existing := &SnapshotResource{Name: "my-snapshot"}
+
+	params, err := spec.Parameters(existing)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if params != nil {
+		t.Errorf("expected nil params for an existing (immutable) snapshot, got %#v", params)
+	}
+}
+
+func TestSnapshotSpecParametersWrongExistingType(t *testing.T) {
+	spec := &SnapshotSpec{Name: "my-snapshot"}
+	if _, err := spec.Parameters("not-a-snapshot"); err == nil {
+		t.Error("expected an error for an unexpected existing type")
+	}
+}
+
+func TestProvisioningStateFromSnapshot(t *testing.T) {
+	cases := map[string]string{
+		"Creating":  "Creating",
+		"Succeeded": "Succeeded",
+		"bogus":     "Failed",
+	}
+	for state, want := range cases {
+		if got := string(ProvisioningStateFromSnapshot(state)); got != want {
+			t.Errorf("ProvisioningStateFromSnapshot(%q) = %q, want %q", state, got, want)
+		}
+	}
+}
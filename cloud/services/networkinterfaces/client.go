@@ -0,0 +1,47 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkinterfaces
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/auth"
+)
+
+// Service fetches network interfaces on behalf of sibling services, e.g. virtualmachines, that
+// need to attach one to a VM.
+type Service struct {
+	Scope  interface{}
+	Client network.InterfacesClient
+}
+
+// NewService returns a networkinterfaces Service for scope, using the given already-authenticated
+// client.
+func NewService(scope interface{}, client network.InterfacesClient) *Service {
+	return &Service{Scope: scope, Client: client}
+}
+
+// NewAuthenticatedClient returns a network.InterfacesClient authenticated with cred, for use as
+// Service.Client, the same way virtualmachines.NewAuthenticatedClient builds its own client.
+func NewAuthenticatedClient(cred azidentity.TokenCredential, subscriptionID string, baseURI string) network.InterfacesClient {
+	if baseURI == "" {
+		baseURI = auth.DefaultARMBaseURI
+	}
+	client := network.NewInterfacesClientWithBaseURI(baseURI, subscriptionID)
+	client.Authorizer = auth.NewAuthorizer(cred, baseURI+"/.default")
+	return client
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkinterfaces
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+type fakeCredential struct{}
+
+func (fakeCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "fake-token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestNewAuthenticatedClientAuthenticatesWithCredential(t *testing.T) {
+	client := NewAuthenticatedClient(fakeCredential{}, "my-subscription", "https://management.example.com")
+	if client.Authorizer == nil {
+		t.Error("expected NewAuthenticatedClient to set an Authorizer derived from the given credential")
+	}
+	if client.SubscriptionID != "my-subscription" {
+		t.Errorf("expected subscription ID %q, got %q", "my-subscription", client.SubscriptionID)
+	}
+	if client.BaseURI != "https://management.example.com" {
+		t.Errorf("expected base URI %q, got %q", "https://management.example.com", client.BaseURI)
+	}
+}
+
+func TestNewAuthenticatedClientDefaultsBaseURI(t *testing.T) {
+	client := NewAuthenticatedClient(fakeCredential{}, "my-subscription", "")
+	if client.BaseURI == "" {
+		t.Error("expected NewAuthenticatedClient to default BaseURI when none is given")
+	}
+}
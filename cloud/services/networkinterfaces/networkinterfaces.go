@@ -0,0 +1,44 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkinterfaces
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/pkg/errors"
+)
+
+// Spec input specification for Get calls.
+type Spec struct {
+	// Name is the name of the network interface.
+	Name string
+
+	// ResourceGroup is the resource group the network interface lives in. Callers default this to
+	// the cluster's own resource group and only override it when the NIC lives in a different
+	// resource group, e.g. a shared hub-and-spoke VNet.
+	ResourceGroup string
+}
+
+// Get provides information about a network interface.
+func (s *Service) Get(ctx context.Context, spec interface{}) (interface{}, error) {
+	nicSpec, ok := spec.(*Spec)
+	if !ok {
+		return network.Interface{}, errors.New("invalid network interface specification")
+	}
+	return s.Client.Get(ctx, nicSpec.ResourceGroup, nicSpec.Name, "")
+}
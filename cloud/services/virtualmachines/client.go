@@ -0,0 +1,35 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualmachines
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/auth"
+)
+
+// NewAuthenticatedClient returns a compute.VirtualMachinesClient authenticated with cred, for use
+// as Service.Client. This replaces building the client from an autorest service-principal
+// authorizer, so the controller pod no longer needs a long-lived client secret.
+func NewAuthenticatedClient(cred azidentity.TokenCredential, subscriptionID string, baseURI string) compute.VirtualMachinesClient {
+	if baseURI == "" {
+		baseURI = auth.DefaultARMBaseURI
+	}
+	client := compute.NewVirtualMachinesClientWithBaseURI(baseURI, subscriptionID)
+	client.Authorizer = auth.NewAuthorizer(cred, baseURI+"/.default")
+	return client
+}
@@ -45,6 +45,20 @@ type Spec struct {
 	Image      infrav1.Image
 	OSDisk     infrav1.OSDisk
 	CustomData string
+
+	// AdminUsername is the username of the admin user created on the VM. Defaults to
+	// azure.DefaultUserName when empty.
+	AdminUsername string
+
+	// ImageResourceGroup, when set, overrides Image.ResourceGroup when building a Shared Image
+	// Gallery image ID, for topologies where images are centrally managed in a resource group
+	// other than the cluster's own. It has no effect on images given by ID or on Marketplace images.
+	ImageResourceGroup string
+
+	// NetworkResourceGroup, when set, overrides the cluster's resource group when looking up the
+	// VM's network interface, for topologies where the VNet/NIC live in a resource group other
+	// than the cluster's own.
+	NetworkResourceGroup string
 }
 
 // Get provides information about a virtual machine.
@@ -75,8 +89,17 @@ func (s *Service) Reconcile(ctx context.Context, spec interface{}) error {
 		return err
 	}
 
+	nicResourceGroup := s.Scope.AzureCluster.Spec.ResourceGroup
+	if vmSpec.NetworkResourceGroup != "" {
+		nicResourceGroup = vmSpec.NetworkResourceGroup
+	}
+
 	klog.V(2).Infof("getting nic %s", vmSpec.NICName)
-	nicInterface, err := networkinterfaces.NewService(s.Scope).Get(ctx, &networkinterfaces.Spec{Name: vmSpec.NICName})
+	// Reuse the authorizer, base URI and subscription ID already on s.Client rather than building
+	// a zero-value network.InterfacesClient, which would send the NIC lookup unauthenticated.
+	nicClient := network.NewInterfacesClientWithBaseURI(s.Client.BaseURI, s.Client.SubscriptionID)
+	nicClient.Authorizer = s.Client.Authorizer
+	nicInterface, err := networkinterfaces.NewService(s.Scope, nicClient).Get(ctx, &networkinterfaces.Spec{Name: vmSpec.NICName, ResourceGroup: nicResourceGroup})
 	if err != nil {
 		return err
 	}
@@ -107,6 +130,11 @@ func (s *Service) Reconcile(ctx context.Context, spec interface{}) error {
 		return errors.Wrapf(err, "failed to generate random string")
 	}
 
+	adminUsername := vmSpec.AdminUsername
+	if adminUsername == "" {
+		adminUsername = azure.DefaultUserName
+	}
+
 	// Make sure to use the MachineScope here to get the merger of AzureCluster and AzureMachine tags
 	additionalTags := s.MachineScope.AdditionalTags()
 	// Set the cloud provider tag
@@ -128,14 +156,14 @@ func (s *Service) Reconcile(ctx context.Context, spec interface{}) error {
 			StorageProfile: storageProfile,
 			OsProfile: &compute.OSProfile{
 				ComputerName:  to.StringPtr(vmSpec.Name),
-				AdminUsername: to.StringPtr(azure.DefaultUserName),
+				AdminUsername: to.StringPtr(adminUsername),
 				AdminPassword: to.StringPtr(randomPassword),
 				CustomData:    to.StringPtr(vmSpec.CustomData),
 				LinuxConfiguration: &compute.LinuxConfiguration{
 					SSH: &compute.SSHConfiguration{
 						PublicKeys: &[]compute.SSHPublicKey{
 							{
-								Path:    to.StringPtr(fmt.Sprintf("/home/%s/.ssh/authorized_keys", azure.DefaultUserName)),
+								Path:    to.StringPtr(fmt.Sprintf("/home/%s/.ssh/authorized_keys", adminUsername)),
 								KeyData: to.StringPtr(sshKeyData),
 							},
 						},
@@ -227,7 +255,12 @@ func generateStorageProfile(vmSpec Spec) (*compute.StorageProfile, error) {
 		},
 	}
 
-	imageRef, err := generateImageReference(vmSpec.Image)
+	image := vmSpec.Image
+	if vmSpec.ImageResourceGroup != "" {
+		image.ResourceGroup = to.StringPtr(vmSpec.ImageResourceGroup)
+	}
+
+	imageRef, err := generateImageReference(image)
 	if err != nil {
 		return nil, err
 	}
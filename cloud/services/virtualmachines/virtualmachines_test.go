@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualmachines
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha2"
+)
+
+func TestGenerateStorageProfileImageResourceGroup(t *testing.T) {
+	testCases := []struct {
+		name               string
+		imageResourceGroup string
+		image              infrav1.Image
+		expectedRG         string
+	}{
+		{
+			name:               "ImageResourceGroup overrides the image's own resource group",
+			imageResourceGroup: "override-rg",
+			image: infrav1.Image{
+				ResourceGroup:  to.StringPtr("original-rg"),
+				SubscriptionID: to.StringPtr("my-sub"),
+				Gallery:        to.StringPtr("my-gallery"),
+				Name:           to.StringPtr("my-image"),
+				Version:        to.StringPtr("1.0.0"),
+			},
+			expectedRG: "override-rg",
+		},
+		{
+			name:               "empty ImageResourceGroup leaves the image's own resource group untouched",
+			imageResourceGroup: "",
+			image: infrav1.Image{
+				ResourceGroup:  to.StringPtr("original-rg"),
+				SubscriptionID: to.StringPtr("my-sub"),
+				Gallery:        to.StringPtr("my-gallery"),
+				Name:           to.StringPtr("my-image"),
+				Version:        to.StringPtr("1.0.0"),
+			},
+			expectedRG: "original-rg",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vmSpec := Spec{
+				Name:               "my-vm",
+				ImageResourceGroup: tc.imageResourceGroup,
+				Image:              tc.image,
+				OSDisk: infrav1.OSDisk{
+					OSType:     "Linux",
+					DiskSizeGB: 30,
+					ManagedDisk: infrav1.ManagedDisk{
+						StorageAccountType: "Premium_LRS",
+					},
+				},
+			}
+
+			storageProfile, err := generateStorageProfile(vmSpec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			wantID := "/subscriptions/my-sub/resourceGroups/" + tc.expectedRG + "/providers/Microsoft.Compute/galleries/my-gallery/images/my-image/versions/1.0.0"
+			if storageProfile.ImageReference == nil || storageProfile.ImageReference.ID == nil || *storageProfile.ImageReference.ID != wantID {
+				t.Errorf("expected image reference ID %q, got %#v", wantID, storageProfile.ImageReference)
+			}
+		})
+	}
+}
+
+func TestGenerateStorageProfileDoesNotMutateSpecImage(t *testing.T) {
+	vmSpec := Spec{
+		Name:               "my-vm",
+		ImageResourceGroup: "override-rg",
+		Image: infrav1.Image{
+			ResourceGroup:  to.StringPtr("original-rg"),
+			SubscriptionID: to.StringPtr("my-sub"),
+			Gallery:        to.StringPtr("my-gallery"),
+			Name:           to.StringPtr("my-image"),
+			Version:        to.StringPtr("1.0.0"),
+		},
+		OSDisk: infrav1.OSDisk{
+			OSType:     "Linux",
+			DiskSizeGB: 30,
+			ManagedDisk: infrav1.ManagedDisk{
+				StorageAccountType: "Premium_LRS",
+			},
+		},
+	}
+
+	if _, err := generateStorageProfile(vmSpec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if *vmSpec.Image.ResourceGroup != "original-rg" {
+		t.Errorf("expected generateStorageProfile to leave vmSpec.Image untouched, got resource group %q", *vmSpec.Image.ResourceGroup)
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/fleets"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// reconcileTimeout is the default timeout for a single reconciliation loop of the
+// AzureManagedFleet and AzureManagedMachinePoolSnapshot reconcilers.
+const reconcileTimeout = 30 * time.Minute
+
+// AzureManagedFleetFinalizer is the finalizer used by the AzureManagedFleetReconciler to ensure
+// fleet members are removed from Azure before the AzureManagedFleet resource is deleted.
+const AzureManagedFleetFinalizer = "azuremanagedfleet.infrastructure.cluster.x-k8s.io"
+
+// AzureManagedFleetReconciler reconciles an AzureManagedFleet object.
+type AzureManagedFleetReconciler struct {
+	client.Client
+	Recorder         record.EventRecorder
+	ReconcileTimeout time.Duration
+
+	// Cred is used to authenticate to Azure when reconciling fleets.
+	Cred azidentity.TokenCredential
+
+	// SubscriptionID is the Azure subscription fleets are reconciled against.
+	SubscriptionID string
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=azuremanagedfleets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=azuremanagedfleets/status,verbs=get;update;patch
+
+// Reconcile joins or removes AKS clusters from an AKS Fleet Manager instance in response to changes to
+// an AzureManagedFleet and its member list.
+func (r *AzureManagedFleetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	timeout := r.ReconcileTimeout
+	if timeout == 0 {
+		timeout = reconcileTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	fleet := &infrav1.AzureManagedFleet{}
+	if err := r.Get(ctx, req.NamespacedName, fleet); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "failed to get AzureManagedFleet")
+	}
+
+	patchHelper, err := patch.NewHelper(fleet, r.Client)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to init patch helper")
+	}
+	defer func() {
+		if patchErr := patchHelper.Patch(ctx, fleet); patchErr != nil && err == nil {
+			err = errors.Wrap(patchErr, "failed to patch AzureManagedFleet")
+		}
+	}()
+
+	if !fleet.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, fleet)
+	}
+
+	return r.reconcileNormal(ctx, fleet)
+}
+
+func (r *AzureManagedFleetReconciler) service() *fleets.Service {
+	return fleets.NewService(r.Cred, r.SubscriptionID)
+}
+
+func buildFleetSpec(fleet *infrav1.AzureManagedFleet) *fleets.FleetSpec {
+	members := make([]fleets.FleetMemberSpec, 0, len(fleet.Spec.Members))
+	for _, m := range fleet.Spec.Members {
+		members = append(members, fleets.FleetMemberSpec{
+			// Name must be a valid child-resource name, so use the AKS cluster's short name
+			// rather than its full ARM resource ID.
+			Name:              path.Base(m.ClusterResourceID),
+			ClusterResourceID: m.ClusterResourceID,
+			Group:             m.Group,
+		})
+	}
+
+	return &fleets.FleetSpec{
+		Name:          fleet.Name,
+		ResourceGroup: fleet.Spec.ResourceGroup,
+		HubProfile: fleets.FleetHubProfile{
+			DNSPrefix: fleet.Spec.HubProfile.DNSPrefix,
+			Version:   fleet.Spec.HubProfile.Version,
+		},
+		Members: members,
+	}
+}
+
+func (r *AzureManagedFleetReconciler) reconcileNormal(ctx context.Context, fleet *infrav1.AzureManagedFleet) (ctrl.Result, error) {
+	controllerutil.AddFinalizer(fleet, AzureManagedFleetFinalizer)
+
+	spec := buildFleetSpec(fleet)
+
+	svc := r.service()
+	if err := svc.Reconcile(ctx, spec); err != nil {
+		r.Recorder.Eventf(fleet, corev1.EventTypeWarning, "ReconcileError", "failed to reconcile fleet: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile fleet")
+	}
+
+	current, err := svc.Get(ctx, spec)
+	if err != nil {
+		r.Recorder.Eventf(fleet, corev1.EventTypeWarning, "ReconcileError", "failed to get fleet: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to get fleet")
+	}
+
+	// A successful PUT only means the request was accepted; the fleet itself may still be
+	// provisioning. Read back its real state rather than assuming it already succeeded.
+	provisioningState := infrav1.Creating
+	if current != nil && current.Properties.ProvisioningState != "" {
+		provisioningState = fleets.ProvisioningStateFromFleet(current.Properties.ProvisioningState)
+	}
+	fleet.Status.ProvisioningState = &provisioningState
+
+	members, err := svc.ListMembers(ctx, spec)
+	if err != nil {
+		r.Recorder.Eventf(fleet, corev1.EventTypeWarning, "ReconcileError", "failed to list fleet members: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to list fleet members")
+	}
+	fleet.Status.MemberStatuses = memberStatuses(fleet.Spec.Members, members)
+
+	ready := provisioningState == infrav1.Succeeded
+	for _, memberStatus := range fleet.Status.MemberStatuses {
+		if memberStatus.ProvisioningState == nil || *memberStatus.ProvisioningState != infrav1.Succeeded {
+			ready = false
+			break
+		}
+	}
+	fleet.Status.Ready = ready
+
+	r.Recorder.Eventf(fleet, corev1.EventTypeNormal, "Reconciled", "successfully reconciled fleet %s", fleet.Name)
+
+	if provisioningState != infrav1.Succeeded && provisioningState != infrav1.Failed && provisioningState != infrav1.Canceled {
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// memberStatuses builds the per-member status for every member desired in spec, reading its
+// provisioning state back from current if Azure has joined it already.
+func memberStatuses(desired []infrav1.AzureManagedFleetMember, current []fleets.FleetMemberResource) []infrav1.AzureManagedFleetMemberStatus {
+	byName := make(map[string]fleets.FleetMemberResource, len(current))
+	for _, m := range current {
+		byName[m.Name] = m
+	}
+
+	statuses := make([]infrav1.AzureManagedFleetMemberStatus, 0, len(desired))
+	for _, m := range desired {
+		status := infrav1.AzureManagedFleetMemberStatus{ClusterResourceID: m.ClusterResourceID}
+		if member, ok := byName[path.Base(m.ClusterResourceID)]; ok && member.Properties.ProvisioningState != "" {
+			ps := fleets.ProvisioningStateFromFleetMember(member.Properties.ProvisioningState)
+			status.ProvisioningState = &ps
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func (r *AzureManagedFleetReconciler) reconcileDelete(ctx context.Context, fleet *infrav1.AzureManagedFleet) (ctrl.Result, error) {
+	spec := buildFleetSpec(fleet)
+
+	svc := r.service()
+	if err := svc.Delete(ctx, spec); err != nil {
+		r.Recorder.Eventf(fleet, corev1.EventTypeWarning, "ReconcileError", "failed to delete fleet: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to delete fleet")
+	}
+
+	controllerutil.RemoveFinalizer(fleet, AzureManagedFleetFinalizer)
+	r.Recorder.Eventf(fleet, corev1.EventTypeNormal, "Deleted", "successfully deleted fleet %s", fleet.Name)
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AzureManagedFleetReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.AzureManagedFleet{}).
+		Complete(r)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/snapshots"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// AzureManagedMachinePoolSnapshotFinalizer ensures the Azure snapshot is deleted before the
+// AzureManagedMachinePoolSnapshot resource itself is removed.
+const AzureManagedMachinePoolSnapshotFinalizer = "azuremanagedmachinepoolsnapshot.infrastructure.cluster.x-k8s.io"
+
+// AzureManagedMachinePoolSnapshotReconciler reconciles an AzureManagedMachinePoolSnapshot object.
+type AzureManagedMachinePoolSnapshotReconciler struct {
+	client.Client
+	Recorder         record.EventRecorder
+	ReconcileTimeout time.Duration
+
+	// Cred is used to authenticate to Azure when reconciling snapshots.
+	Cred azidentity.TokenCredential
+
+	// SubscriptionID is the Azure subscription snapshots are reconciled against.
+	SubscriptionID string
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=azuremanagedmachinepoolsnapshots,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=azuremanagedmachinepoolsnapshots/status,verbs=get;update;patch
+
+// Reconcile creates the Azure snapshot described by an AzureManagedMachinePoolSnapshot, and
+// deletes it when the resource is removed.
+func (r *AzureManagedMachinePoolSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	timeout := r.ReconcileTimeout
+	if timeout == 0 {
+		timeout = reconcileTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	snapshot := &infrav1.AzureManagedMachinePoolSnapshot{}
+	if err := r.Get(ctx, req.NamespacedName, snapshot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "failed to get AzureManagedMachinePoolSnapshot")
+	}
+
+	patchHelper, err := patch.NewHelper(snapshot, r.Client)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to init patch helper")
+	}
+	defer func() {
+		if patchErr := patchHelper.Patch(ctx, snapshot); patchErr != nil && err == nil {
+			err = errors.Wrap(patchErr, "failed to patch AzureManagedMachinePoolSnapshot")
+		}
+	}()
+
+	if !snapshot.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, snapshot)
+	}
+
+	return r.reconcileNormal(ctx, snapshot)
+}
+
+func buildSnapshotSpec(snapshot *infrav1.AzureManagedMachinePoolSnapshot) *snapshots.SnapshotSpec {
+	return &snapshots.SnapshotSpec{
+		Name:             snapshot.Name,
+		ResourceGroup:    snapshot.Spec.ResourceGroup,
+		SourceResourceID: snapshot.Spec.SourceResourceID,
+	}
+}
+
+func (r *AzureManagedMachinePoolSnapshotReconciler) reconcileNormal(ctx context.Context, snapshot *infrav1.AzureManagedMachinePoolSnapshot) (ctrl.Result, error) {
+	controllerutil.AddFinalizer(snapshot, AzureManagedMachinePoolSnapshotFinalizer)
+
+	spec := buildSnapshotSpec(snapshot)
+
+	svc := snapshots.NewService(r.Cred, r.SubscriptionID)
+	if err := svc.Reconcile(ctx, spec); err != nil {
+		r.Recorder.Eventf(snapshot, corev1.EventTypeWarning, "ReconcileError", "failed to reconcile snapshot: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile snapshot")
+	}
+
+	current, err := svc.Get(ctx, spec)
+	if err != nil {
+		r.Recorder.Eventf(snapshot, corev1.EventTypeWarning, "ReconcileError", "failed to get snapshot: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to get snapshot")
+	}
+
+	// A successful PUT only means the request was accepted; the snapshot itself may still be
+	// provisioning. Read back its real state rather than assuming it already succeeded.
+	provisioningState := infrav1.Creating
+	if current != nil && current.Properties.ProvisioningState != "" {
+		provisioningState = snapshots.ProvisioningStateFromSnapshot(current.Properties.ProvisioningState)
+	}
+	snapshot.Status.ProvisioningState = &provisioningState
+	snapshot.Status.Ready = provisioningState == infrav1.Succeeded
+
+	r.Recorder.Eventf(snapshot, corev1.EventTypeNormal, "Reconciled", "successfully reconciled snapshot %s", snapshot.Name)
+
+	if provisioningState != infrav1.Succeeded && provisioningState != infrav1.Failed && provisioningState != infrav1.Canceled {
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *AzureManagedMachinePoolSnapshotReconciler) reconcileDelete(ctx context.Context, snapshot *infrav1.AzureManagedMachinePoolSnapshot) (ctrl.Result, error) {
+	svc := snapshots.NewService(r.Cred, r.SubscriptionID)
+	if err := svc.Delete(ctx, buildSnapshotSpec(snapshot)); err != nil {
+		r.Recorder.Eventf(snapshot, corev1.EventTypeWarning, "ReconcileError", "failed to delete snapshot: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to delete snapshot")
+	}
+
+	controllerutil.RemoveFinalizer(snapshot, AzureManagedMachinePoolSnapshotFinalizer)
+	r.Recorder.Eventf(snapshot, corev1.EventTypeNormal, "Deleted", "successfully deleted snapshot %s", snapshot.Name)
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AzureManagedMachinePoolSnapshotReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.AzureManagedMachinePoolSnapshot{}).
+		Complete(r)
+}
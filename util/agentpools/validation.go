@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package agentpools holds agent pool validation logic shared between the agentpools reconciler
+// and the AzureManagedMachinePool admission webhook. It lives under util rather than
+// azure/services/agentpools so that api/v1beta1 can depend on it without creating an import cycle
+// back through azure/services/agentpools's dependency on api/v1beta1.
+package agentpools
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// kataUnsupportedVMSizePrefixes lists VM size family prefixes that are known not to support
+// nested virtualization, and therefore cannot run the Kata VM-isolated container runtime.
+var kataUnsupportedVMSizePrefixes = []string{
+	"Standard_B",
+	"Standard_A",
+}
+
+// ValidateWorkloadRuntime rejects WorkloadRuntime values that AKS cannot satisfy for the given
+// pool configuration. It is called both from agentpools.AgentPoolSpec.Parameters on every
+// reconcile and from the AzureManagedMachinePool webhook at admission time, so invalid pools are
+// rejected before they are ever written to Azure. In particular, KataMshvVmIsolation requires
+// nested virtualization and is not available on Windows node pools or on VM SKUs that don't
+// support it.
+func ValidateWorkloadRuntime(workloadRuntime *string, osType *string, vmSize string, fieldPath *field.Path) *field.Error {
+	if workloadRuntime == nil || *workloadRuntime != "KataMshvVmIsolation" {
+		return nil
+	}
+
+	if osType != nil && *osType == "Windows" {
+		return field.Invalid(fieldPath, *workloadRuntime, "KataMshvVmIsolation is not supported on Windows node pools")
+	}
+
+	for _, prefix := range kataUnsupportedVMSizePrefixes {
+		if strings.HasPrefix(vmSize, prefix) {
+			return field.Invalid(fieldPath, *workloadRuntime, "KataMshvVmIsolation requires a VM SKU that supports nested virtualization")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentpools
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateWorkloadRuntime(t *testing.T) {
+	kata := "KataMshvVmIsolation"
+	oci := "OCIContainer"
+	windows := "Windows"
+	linux := "Linux"
+	path := field.NewPath("spec", "workloadRuntime")
+
+	cases := []struct {
+		name            string
+		workloadRuntime *string
+		osType          *string
+		vmSize          string
+		wantErr         bool
+	}{
+		{"nil runtime is allowed", nil, &linux, "Standard_D2s_v3", false},
+		{"non-kata runtime is allowed", &oci, &windows, "Standard_B2s", false},
+		{"kata on linux supported SKU is allowed", &kata, &linux, "Standard_D2s_v3", false},
+		{"kata on windows is rejected", &kata, &windows, "Standard_D2s_v3", true},
+		{"kata on unsupported SKU is rejected", &kata, &linux, "Standard_B2s", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateWorkloadRuntime(tc.workloadRuntime, tc.osType, tc.vmSize, path)
+			if tc.wantErr && err == nil {
+				t.Errorf("expected an error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}